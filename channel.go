@@ -0,0 +1,105 @@
+package golist
+
+import (
+	"context"
+	"time"
+)
+
+// ToChannel streams the elements of the List[V] to a channel, closing it
+// once every element has been sent or ctx is done.
+func (l List[V]) ToChannel(ctx context.Context) <-chan V {
+	ch := make(chan V)
+
+	go func() {
+		defer close(ch)
+
+		for _, v := range l.Values() {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// FromChannel collects values from ch into a List[V] until ch is closed, ctx
+// is done, or max elements have been collected (max <= 0 means unbounded).
+func FromChannel[V comparable](ctx context.Context, ch <-chan V, max int) List[V] {
+	var newlist []V
+
+	for {
+		if max > 0 && len(newlist) >= max {
+			return newList(newlist)
+		}
+
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return newList(newlist)
+			}
+
+			newlist = append(newlist, v)
+		case <-ctx.Done():
+			return newList(newlist)
+		}
+	}
+}
+
+// Batch chunks values coming from in into List[V] batches, emitting a batch
+// either once it reaches size elements or flush has elapsed since the first
+// item of the current batch, whichever happens first. The returned channel
+// closes once in is closed, flushing any remaining partial batch first.
+func Batch[V comparable](in <-chan V, size int, flush time.Duration) <-chan List[V] {
+	out := make(chan List[V])
+
+	go func() {
+		defer close(out)
+
+		batch := make([]V, 0, size)
+		var timer *time.Timer
+		var timeout <-chan time.Time
+
+		flushBatch := func() {
+			if len(batch) == 0 {
+				return
+			}
+
+			out <- newList(batch)
+			batch = make([]V, 0, size)
+
+			if timer != nil {
+				timer.Stop()
+				timer = nil
+				timeout = nil
+			}
+		}
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					flushBatch()
+					return
+				}
+
+				if len(batch) == 0 {
+					timer = time.NewTimer(flush)
+					timeout = timer.C
+				}
+
+				batch = append(batch, v)
+
+				if len(batch) >= size {
+					flushBatch()
+				}
+			case <-timeout:
+				flushBatch()
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,80 @@
+package golist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListToChannel(t *testing.T) {
+	ch := Var(1, 2, 3).ToChannel(context.Background())
+
+	values := make([]int, 0, 3)
+	for v := range ch {
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, values)
+}
+
+func TestFromChannel(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	l := FromChannel[int](context.Background(), ch, 0)
+	assert.Equal(t, []int{1, 2, 3}, l.Values())
+}
+
+func TestFromChannelMax(t *testing.T) {
+	ch := make(chan int)
+
+	go func() {
+		defer close(ch)
+		for i := 1; i <= 10; i++ {
+			ch <- i
+		}
+	}()
+
+	l := FromChannel[int](context.Background(), ch, 3)
+	assert.Equal(t, []int{1, 2, 3}, l.Values())
+}
+
+func TestBatchBySize(t *testing.T) {
+	in := make(chan int)
+
+	go func() {
+		defer close(in)
+		for i := 1; i <= 6; i++ {
+			in <- i
+		}
+	}()
+
+	var batches [][]int
+	for batch := range Batch[int](in, 2, time.Second) {
+		batches = append(batches, batch.Values())
+	}
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5, 6}}, batches)
+}
+
+func TestBatchByFlush(t *testing.T) {
+	in := make(chan int)
+
+	go func() {
+		in <- 1
+		time.Sleep(20 * time.Millisecond)
+		close(in)
+	}()
+
+	var batches [][]int
+	for batch := range Batch[int](in, 10, 5*time.Millisecond) {
+		batches = append(batches, batch.Values())
+	}
+
+	assert.Equal(t, [][]int{{1}}, batches)
+}
@@ -0,0 +1,100 @@
+package golist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the List[V] as a plain JSON array.
+func (l List[V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.values)
+}
+
+// UnmarshalJSON decodes a plain JSON array into the List[V], allocating a
+// fresh backing slice rather than aliasing the input buffer.
+func (l *List[V]) UnmarshalJSON(data []byte) error {
+	var values []V
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*l = newList(values)
+
+	return nil
+}
+
+// GobEncode encodes the List[V] as a gob-encoded slice.
+func (l List[V]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := gob.NewEncoder(&buf).Encode(l.values); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes a gob-encoded slice into the List[V], allocating a fresh
+// backing slice rather than aliasing the input buffer.
+func (l *List[V]) GobDecode(data []byte) error {
+	var values []V
+
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+
+	*l = newList(values)
+
+	return nil
+}
+
+// Serializer encodes and decodes a List[V] to and from a byte representation.
+type Serializer[V comparable] interface {
+	Marshal(List[V]) ([]byte, error)
+	Unmarshal([]byte) (List[V], error)
+}
+
+// JSONSerializer is a Serializer[V] backed by encoding/json.
+type JSONSerializer[V comparable] struct{}
+
+// Marshal encodes l as a JSON array.
+func (JSONSerializer[V]) Marshal(l List[V]) ([]byte, error) { return json.Marshal(l.values) }
+
+// Unmarshal decodes a JSON array into a List[V] with a fresh backing slice.
+func (JSONSerializer[V]) Unmarshal(data []byte) (List[V], error) {
+	var values []V
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return List[V]{}, err
+	}
+
+	return newList(values), nil
+}
+
+// GobSerializer is a Serializer[V] backed by encoding/gob.
+type GobSerializer[V comparable] struct{}
+
+// Marshal encodes l as a gob-encoded slice.
+func (GobSerializer[V]) Marshal(l List[V]) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := gob.NewEncoder(&buf).Encode(l.values); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes a gob-encoded slice into a List[V] with a fresh backing
+// slice.
+func (GobSerializer[V]) Unmarshal(data []byte) (List[V], error) {
+	var values []V
+
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return List[V]{}, err
+	}
+
+	return newList(values), nil
+}
@@ -0,0 +1,51 @@
+package golist
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Var(1, 2, 3))
+	assert.Nil(t, err)
+	assert.Equal(t, `[1,2,3]`, string(data))
+}
+
+func TestListUnmarshalJSON(t *testing.T) {
+	var l List[int]
+	assert.Nil(t, json.Unmarshal([]byte(`[1,2,3]`), &l))
+	assert.Equal(t, []int{1, 2, 3}, l.Values())
+}
+
+func TestListGobRoundtrip(t *testing.T) {
+	data, err := Var(1, 2, 3).GobEncode()
+	assert.Nil(t, err)
+
+	var l List[int]
+	assert.Nil(t, l.GobDecode(data))
+	assert.Equal(t, []int{1, 2, 3}, l.Values())
+}
+
+func TestJSONSerializer(t *testing.T) {
+	var s JSONSerializer[int]
+
+	data, err := s.Marshal(Var(1, 2, 3))
+	assert.Nil(t, err)
+
+	l, err := s.Unmarshal(data)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, l.Values())
+}
+
+func TestGobSerializer(t *testing.T) {
+	var s GobSerializer[int]
+
+	data, err := s.Marshal(Var(1, 2, 3))
+	assert.Nil(t, err)
+
+	l, err := s.Unmarshal(data)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, l.Values())
+}
@@ -10,15 +10,42 @@ import (
 	"time"
 )
 
-// List represents go slice of generic values as concurrency safe List[V].
+// List represents an immutable go slice of generic values as List[V]. Every
+// mutator leaves the receiver untouched and returns its result as another
+// List[V], and no method writes into a List[V]'s backing array. Plain copies,
+// such as the one WithRand returns, share the backing array of the List[V]
+// they were copied from. For an in-place, concurrency-safe variant see
+// MutableList[V].
 type List[V comparable] struct {
-	mutex  *sync.RWMutex
 	values []V
+	rng    *safeRand
+}
+
+// safeRand guards a *rand.Rand with a mutex so a single source injected via
+// WithRand can be shared safely across the goroutine-unsafe copies of
+// List[V] derived from it; math/rand.Rand itself is not safe for concurrent
+// use.
+type safeRand struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+func (s *safeRand) Intn(n int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.r.Intn(n)
+}
+
+func (s *safeRand) Shuffle(n int, swap func(i, j int)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.r.Shuffle(n, swap)
 }
 
 func newList[V comparable](values []V) List[V] {
 	return List[V]{
-		mutex:  &sync.RWMutex{},
 		values: values,
 	}
 }
@@ -73,9 +100,6 @@ func (l List[V]) Values() []V { return l.values }
 
 // First return the first element of List[V]
 func (l List[V]) First() V {
-	l.mutex.RLock()
-	defer l.mutex.RUnlock()
-
 	var v V
 
 	if l.Len() > 0 {
@@ -87,9 +111,6 @@ func (l List[V]) First() V {
 
 // Last return the last element of List[V].
 func (l List[V]) Last() V {
-	l.mutex.RLock()
-	defer l.mutex.RUnlock()
-
 	var v V
 
 	if l.Len() > 0 {
@@ -105,25 +126,27 @@ func (l List[V]) Len() int { return len(l.values) }
 // Empty return true if len is zero.
 func (l List[V]) Empty() bool { return l.Len() == 0 }
 
-// Add allow to add element to the List[V].
+// Add returns a new List[V] with v appended, leaving the receiver untouched.
 func (l List[V]) Add(v V) List[V] {
-	l.mutex.Lock()
-	l.values = append(l.values, v)
-	l.mutex.Unlock()
-	return l
+	newlist := make([]V, l.Len(), l.Len()+1)
+	copy(newlist, l.values)
+	newlist = append(newlist, v)
+
+	return newList(newlist)
 }
 
-// Delete deletes the element from slice by index.
+// Delete returns a new List[V] with the element at index removed, leaving
+// the receiver untouched.
 func (l List[V]) Delete(index uint) List[V] {
-	l.mutex.Lock()
-	defer l.mutex.Unlock()
-
 	if l.Len() <= int(index) {
 		return l
 	}
 
-	l.values = append(l.values[:index], l.values[index+1:]...)
-	return l
+	newlist := make([]V, 0, l.Len()-1)
+	newlist = append(newlist, l.values[:index]...)
+	newlist = append(newlist, l.values[index+1:]...)
+
+	return newList(newlist)
 }
 
 // Filter filters element of the List[V].
@@ -163,7 +186,10 @@ func (l List[V]) Chunk(size int) []List[V] {
 			end = sliceLen
 		}
 
-		chunks = append(chunks, newList(slice[i:end]))
+		chunk := make([]V, end-i)
+		copy(chunk, slice[i:end])
+
+		chunks = append(chunks, newList(chunk))
 	}
 
 	return chunks
@@ -216,20 +242,64 @@ func (l List[V]) Nth(nth int) List[V] {
 	return newList(newlist)
 }
 
-// Random return random element from List[V].
+// WithRand return a copy of the List[V] using r as the random source for
+// Random, RandomN and Shuffle, instead of the lazily-created default. r is
+// guarded internally by a mutex, so the returned List[V] and any copies
+// derived from it may safely call Random, RandomN and Shuffle concurrently.
+func (l List[V]) WithRand(r *rand.Rand) List[V] {
+	l.rng = &safeRand{r: r}
+	return l
+}
+
+// rand return the List[V]'s random source, creating a fresh one seeded from
+// the current time if none was set via WithRand.
+func (l List[V]) rand() *safeRand {
+	if l.rng != nil {
+		return l.rng
+	}
+
+	return &safeRand{r: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+// Random return random element from List[V]. It returns the zero value of V
+// if the List[V] is empty.
 func (l List[V]) Random() V {
-	l.mutex.RLock()
-	defer l.mutex.RUnlock()
+	var v V
 
-	rand.Seed(time.Now().Unix())
-	return l.values[rand.Intn(l.Len())]
+	if l.Empty() {
+		return v
+	}
+
+	return l.values[l.rand().Intn(l.Len())]
+}
+
+// RandomN return n elements sampled from the List[V] without replacement,
+// using Fisher-Yates on a copy and stopping after n swaps. n is clamped to
+// the List[V]'s length.
+func (l List[V]) RandomN(n int) List[V] {
+	if n > l.Len() {
+		n = l.Len()
+	}
+
+	if n <= 0 {
+		return newList(make([]V, 0))
+	}
+
+	newlist := make([]V, l.Len())
+	copy(newlist, l.values)
+
+	r := l.rand()
+
+	for i := 0; i < n; i++ {
+		j := i + r.Intn(len(newlist)-i)
+		newlist[i], newlist[j] = newlist[j], newlist[i]
+	}
+
+	return newList(newlist[:n])
 }
 
 // Contains check that V exists in List[V].
 func (l List[V]) Contains(v V) bool {
-	l.mutex.RLock()
-	defer l.mutex.RUnlock()
-
 	for _, lv := range l.values {
 		if lv == v {
 			return true
@@ -256,8 +326,7 @@ func (l List[V]) Shuffle() List[V] {
 	newlist := make([]V, l.Len())
 	copy(newlist, l.values)
 
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(newlist), func(i, j int) { newlist[i], newlist[j] = newlist[j], newlist[i] })
+	l.rand().Shuffle(len(newlist), func(i, j int) { newlist[i], newlist[j] = newlist[j], newlist[i] })
 
 	return newList(newlist)
 }
@@ -0,0 +1,148 @@
+package golist
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MutableList represents a concurrency-safe, in-place counterpart to
+// List[V]: Add, Delete, Append, Set and Clear mutate the underlying slice
+// under an internal sync.RWMutex instead of allocating a new List[V]. Use
+// Snapshot to cross over to the immutable List[V].
+type MutableList[V comparable] struct {
+	mutex  sync.RWMutex
+	values []V
+}
+
+// NewMutableList creates the MutableList[V] from the given slice.
+func NewMutableList[V comparable](values []V) *MutableList[V] {
+	return &MutableList[V]{values: values}
+}
+
+// Len return actual slice len.
+func (l *MutableList[V]) Len() int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return len(l.values)
+}
+
+// Empty return true if len is zero.
+func (l *MutableList[V]) Empty() bool { return l.Len() == 0 }
+
+// Values return a copy of the builtin slice of V, so the caller never holds
+// a reference aliasing the MutableList[V]'s internal storage.
+func (l *MutableList[V]) Values() []V {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	newlist := make([]V, len(l.values))
+	copy(newlist, l.values)
+
+	return newlist
+}
+
+// First return the first element of MutableList[V].
+func (l *MutableList[V]) First() V {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	var v V
+
+	if len(l.values) > 0 {
+		v = l.values[0]
+	}
+
+	return v
+}
+
+// Last return the last element of MutableList[V].
+func (l *MutableList[V]) Last() V {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	var v V
+
+	if len(l.values) > 0 {
+		v = l.values[len(l.values)-1]
+	}
+
+	return v
+}
+
+// Contains check that V exists in MutableList[V].
+func (l *MutableList[V]) Contains(v V) bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	for _, lv := range l.values {
+		if lv == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Add appends v to the MutableList[V] in place.
+func (l *MutableList[V]) Add(v V) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.values = append(l.values, v)
+}
+
+// Append appends values to the MutableList[V] in place.
+func (l *MutableList[V]) Append(values ...V) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.values = append(l.values, values...)
+}
+
+// Delete removes the element at index from the MutableList[V] in place.
+func (l *MutableList[V]) Delete(index uint) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if len(l.values) <= int(index) {
+		return
+	}
+
+	l.values = append(l.values[:index], l.values[index+1:]...)
+}
+
+// Set replaces the element at index in place, returning an error if index is
+// out of range.
+func (l *MutableList[V]) Set(index int, v V) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if index < 0 || index >= len(l.values) {
+		return fmt.Errorf("golist: index %d out of range", index)
+	}
+
+	l.values[index] = v
+
+	return nil
+}
+
+// Clear empties the MutableList[V] in place.
+func (l *MutableList[V]) Clear() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.values = l.values[:0]
+}
+
+// Snapshot copies the current values of the MutableList[V] into an
+// immutable List[V].
+func (l *MutableList[V]) Snapshot() List[V] {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	newlist := make([]V, len(l.values))
+	copy(newlist, l.values)
+
+	return newList(newlist)
+}
@@ -0,0 +1,65 @@
+package golist
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutableListAdd(t *testing.T) {
+	l := NewMutableList([]int{1, 2})
+	l.Add(3)
+	assert.Equal(t, []int{1, 2, 3}, l.Values())
+}
+
+func TestMutableListAppend(t *testing.T) {
+	l := NewMutableList([]int{1})
+	l.Append(2, 3)
+	assert.Equal(t, []int{1, 2, 3}, l.Values())
+}
+
+func TestMutableListDelete(t *testing.T) {
+	l := NewMutableList([]int{1, 2, 3})
+	l.Delete(1)
+	assert.Equal(t, []int{1, 3}, l.Values())
+}
+
+func TestMutableListSet(t *testing.T) {
+	l := NewMutableList([]int{1, 2, 3})
+	assert.Nil(t, l.Set(1, 20))
+	assert.Equal(t, []int{1, 20, 3}, l.Values())
+	assert.Error(t, l.Set(10, 0))
+}
+
+func TestMutableListClear(t *testing.T) {
+	l := NewMutableList([]int{1, 2, 3})
+	l.Clear()
+	assert.True(t, l.Empty())
+}
+
+func TestMutableListSnapshot(t *testing.T) {
+	l := NewMutableList([]int{1, 2, 3})
+	snapshot := l.Snapshot()
+
+	l.Add(4)
+
+	assert.Equal(t, []int{1, 2, 3}, snapshot.Values())
+	assert.Equal(t, []int{1, 2, 3, 4}, l.Values())
+}
+
+func TestMutableListConcurrentAdd(t *testing.T) {
+	l := NewMutableList([]int{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(v int) {
+			defer wg.Done()
+			l.Add(v)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 100, l.Len())
+}
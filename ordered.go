@@ -0,0 +1,101 @@
+package golist
+
+import (
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// Sort return a sorted copy of the List[V] in ascending order.
+func Sort[V constraints.Ordered](l List[V]) List[V] {
+	newlist := make([]V, l.Len())
+	copy(newlist, l.Values())
+
+	slices.Sort(newlist)
+
+	return newList(newlist)
+}
+
+// SortBy return a copy of the List[V] sorted by the given less function.
+func SortBy[V comparable](l List[V], less func(a, b V) bool) List[V] {
+	newlist := make([]V, l.Len())
+	copy(newlist, l.Values())
+
+	slices.SortFunc(newlist, func(a, b V) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return newList(newlist)
+}
+
+// Min return the smallest element of the List[V].
+func Min[V constraints.Ordered](l List[V]) V {
+	var min V
+
+	for i, v := range l.Values() {
+		if i == 0 || v < min {
+			min = v
+		}
+	}
+
+	return min
+}
+
+// Max return the largest element of the List[V].
+func Max[V constraints.Ordered](l List[V]) V {
+	var max V
+
+	for i, v := range l.Values() {
+		if i == 0 || v > max {
+			max = v
+		}
+	}
+
+	return max
+}
+
+// Sum return the sum of all elements of the List[V].
+func Sum[V constraints.Ordered](l List[V]) V {
+	var sum V
+
+	for _, v := range l.Values() {
+		sum += v
+	}
+
+	return sum
+}
+
+// GroupBy groups the elements of the List[V] by the given key func.
+func GroupBy[V comparable, K comparable](l List[V], key func(V) K) map[K]List[V] {
+	groups := make(map[K][]V)
+
+	for _, v := range l.Values() {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+
+	result := make(map[K]List[V], len(groups))
+	for k, values := range groups {
+		result[k] = newList(values)
+	}
+
+	return result
+}
+
+// Reduce folds the List[V] into a single value A, applying fn to the
+// accumulator and each element in order, starting from init.
+func Reduce[V comparable, A any](l List[V], init A, fn func(A, V) A) A {
+	acc := init
+
+	for _, v := range l.Values() {
+		acc = fn(acc, v)
+	}
+
+	return acc
+}
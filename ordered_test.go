@@ -0,0 +1,39 @@
+package golist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3, 4}, Sort(Var(3, 1, 4, 2)).Values())
+}
+
+func TestSortBy(t *testing.T) {
+	l := SortBy(Var(3, 1, 4, 2), func(a, b int) bool { return a > b })
+	assert.Equal(t, []int{4, 3, 2, 1}, l.Values())
+}
+
+func TestMin(t *testing.T) {
+	assert.Equal(t, 1, Min(Var(3, 1, 4, 2)))
+}
+
+func TestMax(t *testing.T) {
+	assert.Equal(t, 4, Max(Var(3, 1, 4, 2)))
+}
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 10, Sum(Var(1, 2, 3, 4)))
+}
+
+func TestGroupBy(t *testing.T) {
+	groups := GroupBy(Var(1, 2, 3, 4, 5, 6), func(v int) int { return v % 2 })
+	assert.Equal(t, []int{2, 4, 6}, groups[0].Values())
+	assert.Equal(t, []int{1, 3, 5}, groups[1].Values())
+}
+
+func TestReduce(t *testing.T) {
+	sum := Reduce(Var(1, 2, 3, 4), 0, func(acc, v int) int { return acc + v })
+	assert.Equal(t, 10, sum)
+}
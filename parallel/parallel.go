@@ -0,0 +1,164 @@
+// Package parallel mirrors golist's Each, Filter and Every but runs the
+// mapper/filter concurrently over a bounded worker pool. It follows the same
+// split samber/lo makes between its core package and lo/parallel.
+package parallel
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/kafkiansky/golist"
+)
+
+// workers resolves the concurrency degree to use for n elements: the first
+// value of concurrency if given and positive, otherwise runtime.GOMAXPROCS,
+// clamped to [1, n].
+func workers(n int, concurrency []int) int {
+	d := runtime.GOMAXPROCS(0)
+
+	if len(concurrency) > 0 && concurrency[0] > 0 {
+		d = concurrency[0]
+	}
+
+	if d > n {
+		d = n
+	}
+
+	if d < 1 {
+		d = 1
+	}
+
+	return d
+}
+
+// spread runs fn(i) for every index in [0, n) across a bounded pool of
+// goroutines, chunking the work to avoid per-element goroutine overhead, and
+// blocks until all of them complete.
+func spread(n int, concurrency []int, fn func(i int)) {
+	if n == 0 {
+		return
+	}
+
+	d := workers(n, concurrency)
+	chunk := (n + d - 1) / d
+
+	var wg sync.WaitGroup
+
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+
+			for i := start; i < end; i++ {
+				fn(i)
+			}
+		}(start, end)
+	}
+
+	wg.Wait()
+}
+
+// firstErr returns the first non-nil error in errs, scanning by index so the
+// result does not depend on which worker happened to finish first.
+func firstErr(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Each applies fn to each element of l concurrently, using concurrency[0]
+// workers if given (default runtime.GOMAXPROCS), and returns the new List[E].
+// The result preserves the input order regardless of which worker finishes
+// first.
+func Each[V, E comparable](l golist.List[V], fn func(V) E, concurrency ...int) golist.List[E] {
+	values := l.Values()
+	newlist := make([]E, len(values))
+
+	spread(len(values), concurrency, func(i int) {
+		newlist[i] = fn(values[i])
+	})
+
+	return golist.From(newlist)
+}
+
+// EachE is the error-returning variant of Each. fn is applied to every
+// element even after an error; EachE returns the first non-nil error produced
+// by fn, by input order, alongside the List[E] of all values fn returned.
+func EachE[V, E comparable](l golist.List[V], fn func(V) (E, error), concurrency ...int) (golist.List[E], error) {
+	values := l.Values()
+	newlist := make([]E, len(values))
+	errs := make([]error, len(values))
+
+	spread(len(values), concurrency, func(i int) {
+		newlist[i], errs[i] = fn(values[i])
+	})
+
+	return golist.From(newlist), firstErr(errs)
+}
+
+// Filter filters the elements of l concurrently, using concurrency[0]
+// workers if given (default runtime.GOMAXPROCS). The result preserves the
+// order of l regardless of which worker finishes first.
+func Filter[V comparable](l golist.List[V], filter func(V) bool, concurrency ...int) golist.List[V] {
+	values := l.Values()
+	matched := make([]bool, len(values))
+
+	spread(len(values), concurrency, func(i int) {
+		matched[i] = filter(values[i])
+	})
+
+	newlist := make([]V, 0, len(values))
+	for i, v := range values {
+		if matched[i] {
+			newlist = append(newlist, v)
+		}
+	}
+
+	return golist.From(newlist)
+}
+
+// FilterE is the error-returning variant of Filter. filter is applied to
+// every element even after an error; FilterE returns the first non-nil error
+// produced by filter, by input order, alongside the elements for which filter
+// returned true.
+func FilterE[V comparable](l golist.List[V], filter func(V) (bool, error), concurrency ...int) (golist.List[V], error) {
+	values := l.Values()
+	matched := make([]bool, len(values))
+	errs := make([]error, len(values))
+
+	spread(len(values), concurrency, func(i int) {
+		matched[i], errs[i] = filter(values[i])
+	})
+
+	newlist := make([]V, 0, len(values))
+	for i, v := range values {
+		if matched[i] {
+			newlist = append(newlist, v)
+		}
+	}
+
+	return golist.From(newlist), firstErr(errs)
+}
+
+// Every applies fn to each element of l concurrently, using concurrency[0]
+// workers if given (default runtime.GOMAXPROCS), and returns the plain slice
+// of results in input order.
+func Every[V comparable, E any](l golist.List[V], fn func(V) E, concurrency ...int) []E {
+	values := l.Values()
+	newlist := make([]E, len(values))
+
+	spread(len(values), concurrency, func(i int) {
+		newlist[i] = fn(values[i])
+	})
+
+	return newlist
+}
@@ -0,0 +1,78 @@
+package parallel
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kafkiansky/golist"
+)
+
+func TestEach(t *testing.T) {
+	l := golist.Range(1, 1000)
+	assert.Equal(t, golist.Each(l, func(v int) int { return v * 2 }).Values(), Each(l, func(v int) int { return v * 2 }).Values())
+}
+
+func TestEachE(t *testing.T) {
+	l := golist.Var(1, 2, 3)
+
+	result, err := EachE(l, func(v int) (string, error) { return strconv.Itoa(v), nil })
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, result.Values())
+
+	_, err = EachE(l, func(v int) (string, error) {
+		if v == 2 {
+			return "", fmt.Errorf("boom")
+		}
+		return strconv.Itoa(v), nil
+	})
+	assert.EqualError(t, err, "boom")
+}
+
+func TestFilter(t *testing.T) {
+	l := golist.Range(1, 1000)
+	assert.Equal(t, l.Filter(func(v int) bool { return v%2 == 0 }).Values(), Filter(l, func(v int) bool { return v%2 == 0 }).Values())
+}
+
+func TestFilterE(t *testing.T) {
+	l := golist.Var(1, 2, 3, 4)
+
+	result, err := FilterE(l, func(v int) (bool, error) { return v%2 == 0, nil })
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 4}, result.Values())
+
+	_, err = FilterE(l, func(v int) (bool, error) {
+		if v == 3 {
+			return false, fmt.Errorf("boom")
+		}
+		return v%2 == 0, nil
+	})
+	assert.EqualError(t, err, "boom")
+}
+
+func TestEvery(t *testing.T) {
+	l := golist.Range(1, 1000)
+	assert.Equal(t, golist.Every(l, func(v int) int { return v * 2 }), Every(l, func(v int) int { return v * 2 }))
+}
+
+func BenchmarkEachSerial(b *testing.B) {
+	l := golist.Range(1, 1_000_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		golist.Each(l, func(v int) int { return v * 2 })
+	}
+}
+
+func BenchmarkEachParallel(b *testing.B) {
+	l := golist.Range(1, 1_000_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Each(l, func(v int) int { return v * 2 })
+	}
+}
@@ -0,0 +1,44 @@
+package golist
+
+// Partition splits the List[V] in a single pass into the elements matching
+// pred and the rest, preserving the original order in both.
+func Partition[V comparable](l List[V], pred func(V) bool) (matched, rest List[V]) {
+	matchedValues := make([]V, 0, l.Len())
+	restValues := make([]V, 0, l.Len())
+
+	for _, v := range l.Values() {
+		if pred(v) {
+			matchedValues = append(matchedValues, v)
+		} else {
+			restValues = append(restValues, v)
+		}
+	}
+
+	return newList(matchedValues), newList(restValues)
+}
+
+// Find returns the first element of the List[V] matching pred, and whether
+// one was found.
+func Find[V comparable](l List[V], pred func(V) bool) (V, bool) {
+	for _, v := range l.Values() {
+		if pred(v) {
+			return v, true
+		}
+	}
+
+	var zero V
+
+	return zero, false
+}
+
+// FindIndex returns the index of the first element of the List[V] matching
+// pred, and whether one was found.
+func FindIndex[V comparable](l List[V], pred func(V) bool) (int, bool) {
+	for i, v := range l.Values() {
+		if pred(v) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
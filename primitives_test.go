@@ -0,0 +1,31 @@
+package golist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartition(t *testing.T) {
+	matched, rest := Partition(Var(1, 2, 3, 4, 5, 6), func(v int) bool { return v%2 == 0 })
+	assert.Equal(t, []int{2, 4, 6}, matched.Values())
+	assert.Equal(t, []int{1, 3, 5}, rest.Values())
+}
+
+func TestFind(t *testing.T) {
+	v, ok := Find(Var(1, 2, 3), func(v int) bool { return v > 1 })
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	_, ok = Find(Var(1, 2, 3), func(v int) bool { return v > 10 })
+	assert.False(t, ok)
+}
+
+func TestFindIndex(t *testing.T) {
+	i, ok := FindIndex(Var(1, 2, 3), func(v int) bool { return v > 1 })
+	assert.True(t, ok)
+	assert.Equal(t, 1, i)
+
+	_, ok = FindIndex(Var(1, 2, 3), func(v int) bool { return v > 10 })
+	assert.False(t, ok)
+}
@@ -0,0 +1,49 @@
+package golist
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRandomEmpty(t *testing.T) {
+	assert.Equal(t, 0, Var[int]().Random())
+}
+
+func TestListWithRand(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+
+	a := L(values).WithRand(rand.New(rand.NewSource(1)))
+	b := L(values).WithRand(rand.New(rand.NewSource(1)))
+
+	assert.Equal(t, a.Random(), b.Random())
+}
+
+func TestListWithRandConcurrent(t *testing.T) {
+	l := L([]int{1, 2, 3, 4, 5}).WithRand(rand.New(rand.NewSource(1)))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.Random()
+			l.Shuffle()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestListRandomN(t *testing.T) {
+	l := L([]int{1, 2, 3, 4, 5}).RandomN(3)
+	assert.Equal(t, 3, l.Len())
+
+	for _, v := range l.Values() {
+		assert.True(t, Var(1, 2, 3, 4, 5).Contains(v))
+	}
+
+	assert.Equal(t, 5, L([]int{1, 2, 3, 4, 5}).RandomN(10).Len())
+	assert.True(t, L([]int{1, 2, 3}).RandomN(0).Empty())
+}
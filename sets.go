@@ -0,0 +1,116 @@
+package golist
+
+// toSet builds a hash-set out of values, used to run the set-algebra
+// operations below in O(n+m).
+func toSet[V comparable](values []V) map[V]struct{} {
+	set := make(map[V]struct{}, len(values))
+
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+
+	return set
+}
+
+// Union joins the target list together with other lists, preserving
+// first-seen order and dropping duplicates.
+func (l List[V]) Union(lists ...List[V]) List[V] {
+	seen := make(map[V]struct{}, l.Len())
+	newlist := make([]V, 0, l.Len())
+
+	add := func(v V) {
+		if _, exists := seen[v]; !exists {
+			seen[v] = struct{}{}
+			newlist = append(newlist, v)
+		}
+	}
+
+	for _, v := range l.values {
+		add(v)
+	}
+
+	for _, list := range lists {
+		for _, v := range list.values {
+			add(v)
+		}
+	}
+
+	return newList(newlist)
+}
+
+// matches returns the values of l also present in other, built from a
+// hash-set over whichever of l/other is smaller so the lookup never costs
+// more memory than the smaller operand.
+func (l List[V]) matches(other List[V]) map[V]struct{} {
+	small, big := other, l
+	if l.Len() < other.Len() {
+		small, big = l, other
+	}
+
+	set := toSet(small.values)
+	matches := make(map[V]struct{}, len(set))
+
+	for _, v := range big.values {
+		if _, exists := set[v]; exists {
+			matches[v] = struct{}{}
+		}
+	}
+
+	return matches
+}
+
+// Intersect returns the elements present in both the target list and other,
+// preserving the order of the receiver.
+func (l List[V]) Intersect(other List[V]) List[V] {
+	matches := l.matches(other)
+	newlist := make([]V, 0, l.Len())
+
+	for _, v := range l.values {
+		if _, exists := matches[v]; exists {
+			newlist = append(newlist, v)
+		}
+	}
+
+	return newList(newlist)
+}
+
+// Difference returns the elements of the target list not present in other,
+// preserving the order of the receiver.
+func (l List[V]) Difference(other List[V]) List[V] {
+	matches := l.matches(other)
+	newlist := make([]V, 0, l.Len())
+
+	for _, v := range l.values {
+		if _, exists := matches[v]; !exists {
+			newlist = append(newlist, v)
+		}
+	}
+
+	return newList(newlist)
+}
+
+// SymmetricDifference returns the elements present in either the target
+// list or other, but not in both.
+func (l List[V]) SymmetricDifference(other List[V]) List[V] {
+	return l.Difference(other).Join(other.Difference(l))
+}
+
+// IsSubset reports whether every element of the target list is present in
+// other.
+func (l List[V]) IsSubset(other List[V]) bool {
+	set := toSet(other.values)
+
+	for _, v := range l.values {
+		if _, exists := set[v]; !exists {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal reports whether the target list and other contain the same
+// elements, regardless of order or duplicates.
+func (l List[V]) Equal(other List[V]) bool {
+	return l.IsSubset(other) && other.IsSubset(l)
+}
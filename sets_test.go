@@ -0,0 +1,37 @@
+package golist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListUnion(t *testing.T) {
+	l := Var(1, 2, 3).Union(Var(3, 4), Var(4, 5))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, l.Values())
+}
+
+func TestListIntersect(t *testing.T) {
+	l := Var(1, 2, 3, 4).Intersect(Var(2, 4, 6))
+	assert.Equal(t, []int{2, 4}, l.Values())
+}
+
+func TestListDifference(t *testing.T) {
+	l := Var(1, 2, 3, 4).Difference(Var(2, 4))
+	assert.Equal(t, []int{1, 3}, l.Values())
+}
+
+func TestListSymmetricDifference(t *testing.T) {
+	l := Var(1, 2, 3).SymmetricDifference(Var(2, 3, 4))
+	assert.Equal(t, []int{1, 4}, l.Values())
+}
+
+func TestListIsSubset(t *testing.T) {
+	assert.True(t, Var(1, 2).IsSubset(Var(1, 2, 3)))
+	assert.False(t, Var(1, 4).IsSubset(Var(1, 2, 3)))
+}
+
+func TestListEqual(t *testing.T) {
+	assert.True(t, Var(1, 2, 3).Equal(Var(3, 2, 1)))
+	assert.False(t, Var(1, 2).Equal(Var(1, 2, 3)))
+}